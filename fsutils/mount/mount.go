@@ -8,17 +8,27 @@ import (
 	"bufio"
 	"fmt"
 	"os"
+	"sort"
+	"strconv"
 	"strings"
-	"syscall"
+	"sync"
+	"time"
 
 	"github.com/platinasystems/go/flags"
 	"github.com/platinasystems/go/parms"
 )
 
-// hack around syscall incorrect definition
-const MS_NOUSER uintptr = (1 << 31)
 const procFilesystems = "/proc/filesystems"
 
+// Mounter abstracts the OS-specific mount(2)/unmount(2) (or nearest
+// equivalent) syscalls, so this package builds on more than just Linux.
+// Each mount_$GOOS.go supplies a Mounter, the MS_* flag bits it understands
+// (0 for any it doesn't), and an isMounted probe.
+type Mounter interface {
+	Mount(source, target, fstype string, flags uintptr, data string) error
+	Unmount(target string, flags int) error
+}
+
 type mount struct{}
 
 type fstabEntry struct {
@@ -30,6 +40,7 @@ type fstabEntry struct {
 
 type Filesystems struct {
 	name string
+	once sync.Once
 	list []string
 }
 
@@ -40,48 +51,164 @@ var translations = []struct {
 	bits uintptr
 	set  bool
 }{
-	{"-read-only", syscall.MS_RDONLY, true},
-	{"-read-write", syscall.MS_RDONLY, false},
-	{"-suid", syscall.MS_NOSUID, false},
-	{"-no-suid", syscall.MS_NOSUID, true},
-	{"-dev", syscall.MS_NODEV, false},
-	{"-no-dev", syscall.MS_NODEV, true},
-	{"-exec", syscall.MS_NOEXEC, false},
-	{"-no-exec", syscall.MS_NOEXEC, true},
-	{"-synchronous", syscall.MS_SYNCHRONOUS, true},
-	{"-no-synchronous", syscall.MS_SYNCHRONOUS, true},
-	{"-remount", syscall.MS_REMOUNT, true},
-	{"-mand", syscall.MS_MANDLOCK, true},
-	{"-no-mand", syscall.MS_MANDLOCK, false},
-	{"-dirsync", syscall.MS_DIRSYNC, true},
-	{"-no-dirsync", syscall.MS_DIRSYNC, false},
-	{"-atime", syscall.MS_NOATIME, false},
-	{"-no-atime", syscall.MS_NOATIME, true},
-	{"-diratime", syscall.MS_NODIRATIME, false},
-	{"-no-diratime", syscall.MS_NODIRATIME, true},
-	{"-bind", syscall.MS_BIND, true},
-	{"-move", syscall.MS_MOVE, true},
-	{"-silent", syscall.MS_SILENT, true},
-	{"-loud", syscall.MS_SILENT, false},
-	{"-posixacl", syscall.MS_POSIXACL, true},
-	{"-no-posixacl", syscall.MS_POSIXACL, false},
-	{"-bindable", syscall.MS_UNBINDABLE, false},
-	{"-unbindable", syscall.MS_UNBINDABLE, true},
-	{"-private", syscall.MS_PRIVATE, true},
-	{"-slave", syscall.MS_SLAVE, true},
-	{"-shared", syscall.MS_SHARED, true},
-	{"-relatime", syscall.MS_RELATIME, true},
-	{"-no-relatime", syscall.MS_RELATIME, false},
-	{"-iversion", syscall.MS_I_VERSION, true},
-	{"-no-iversion", syscall.MS_I_VERSION, false},
-	{"-strictatime", syscall.MS_STRICTATIME, true},
-	{"-no-strictatime", syscall.MS_STRICTATIME, false},
+	{"-r", MS_RDONLY, true},
+	{"-read-write", MS_RDONLY, false},
+	{"-suid", MS_NOSUID, false},
+	{"-no-suid", MS_NOSUID, true},
+	{"-dev", MS_NODEV, false},
+	{"-no-dev", MS_NODEV, true},
+	{"-exec", MS_NOEXEC, false},
+	{"-no-exec", MS_NOEXEC, true},
+	{"-synchronous", MS_SYNCHRONOUS, true},
+	{"-no-synchronous", MS_SYNCHRONOUS, false},
+	{"-remount", MS_REMOUNT, true},
+	{"-mand", MS_MANDLOCK, true},
+	{"-no-mand", MS_MANDLOCK, false},
+	{"-dirsync", MS_DIRSYNC, true},
+	{"-no-dirsync", MS_DIRSYNC, false},
+	{"-atime", MS_NOATIME, false},
+	{"-no-atime", MS_NOATIME, true},
+	{"-diratime", MS_NODIRATIME, false},
+	{"-no-diratime", MS_NODIRATIME, true},
+	{"-bind", MS_BIND, true},
+	{"-move", MS_MOVE, true},
+	{"-silent", MS_SILENT, true},
+	{"-loud", MS_SILENT, false},
+	{"-posixacl", MS_POSIXACL, true},
+	{"-no-posixacl", MS_POSIXACL, false},
+	{"-bindable", MS_UNBINDABLE, false},
+	{"-unbindable", MS_UNBINDABLE, true},
+	{"-private", MS_PRIVATE, true},
+	{"-slave", MS_SLAVE, true},
+	{"-shared", MS_SHARED, true},
+	{"-relatime", MS_RELATIME, true},
+	{"-no-relatime", MS_RELATIME, false},
+	{"-iversion", MS_I_VERSION, true},
+	{"-no-iversion", MS_I_VERSION, false},
+	{"-strictatime", MS_STRICTATIME, true},
+	{"-no-strictatime", MS_STRICTATIME, false},
+	{"-rbind", MS_BIND | MS_REC, true},
+	{"-rprivate", MS_PRIVATE | MS_REC, true},
+	{"-rslave", MS_SLAVE | MS_REC, true},
+	{"-rshared", MS_SHARED | MS_REC, true},
+	{"-runbindable", MS_UNBINDABLE | MS_REC, true},
+	{"-lazytime", MS_LAZYTIME, true},
+	{"-nosymfollow", MS_NOSYMFOLLOW, true},
+}
+
+// makeTranslations maps the -make-* propagation-only flags to the bits
+// passed in a bare Mount("", target, "", bits, "") call, letting a mount
+// tree's propagation type be changed without unmounting it.
+var makeTranslations = []struct {
+	name string
+	bits uintptr
+}{
+	{"-make-shared", MS_SHARED},
+	{"-make-slave", MS_SLAVE},
+	{"-make-private", MS_PRIVATE},
+	{"-make-unbindable", MS_UNBINDABLE},
+	{"-make-rshared", MS_SHARED | MS_REC},
+	{"-make-rslave", MS_SLAVE | MS_REC},
+	{"-make-rprivate", MS_PRIVATE | MS_REC},
+	{"-make-runbindable", MS_UNBINDABLE | MS_REC},
 }
 
 var filesystems struct {
 	all, auto Filesystems
 }
 
+// fstabOptions maps the comma-separated keywords found in the "-o" flag
+// and the fourth column of /etc/fstab to the MS_* bit they select,
+// mirroring the -FLAG/-no-FLAG pairs in translations above.
+var fstabOptions = []struct {
+	name string
+	bits uintptr
+	set  bool
+}{
+	{"ro", MS_RDONLY, true},
+	{"rw", MS_RDONLY, false},
+	{"suid", MS_NOSUID, false},
+	{"nosuid", MS_NOSUID, true},
+	{"dev", MS_NODEV, false},
+	{"nodev", MS_NODEV, true},
+	{"exec", MS_NOEXEC, false},
+	{"noexec", MS_NOEXEC, true},
+	{"sync", MS_SYNCHRONOUS, true},
+	{"async", MS_SYNCHRONOUS, false},
+	{"dirsync", MS_DIRSYNC, true},
+	{"atime", MS_NOATIME, false},
+	{"noatime", MS_NOATIME, true},
+	{"diratime", MS_NODIRATIME, false},
+	{"nodiratime", MS_NODIRATIME, true},
+	{"relatime", MS_RELATIME, true},
+	{"norelatime", MS_RELATIME, false},
+	{"strictatime", MS_STRICTATIME, true},
+	{"bind", MS_BIND, true},
+	{"rbind", MS_BIND | MS_REC, true},
+	{"remount", MS_REMOUNT, true},
+	{"mand", MS_MANDLOCK, true},
+	{"nomand", MS_MANDLOCK, false},
+	{"iversion", MS_I_VERSION, true},
+	{"private", MS_PRIVATE, true},
+	{"slave", MS_SLAVE, true},
+	{"shared", MS_SHARED, true},
+	{"unbindable", MS_UNBINDABLE, true},
+}
+
+// pseudoMountOptions are fstab(5) keywords that only mean something to
+// userspace (mount -a's entry selection, or the long-unsupported "user"
+// mount family) and must never reach the kernel as part of the fs-specific
+// data string. "comment=" and "x-*" are the fstab(5) conventions for
+// attaching arbitrary metadata to an entry.
+var pseudoMountOptions = []string{
+	"auto", "noauto", "fail", "nofail", "_netdev",
+	"user", "users", "nouser", "owner", "group",
+}
+
+func isPseudoMountOption(opt string) bool {
+	if strings.HasPrefix(opt, "comment=") || strings.HasPrefix(opt, "x-") {
+		return true
+	}
+	for _, p := range pseudoMountOptions {
+		if opt == p {
+			return true
+		}
+	}
+	return false
+}
+
+// parseMountOptions splits a comma-separated fstab-style option string (the
+// "-o" flag, or the fourth column of an /etc/fstab entry) into the MS_*
+// flags it selects and the residual filesystem-specific data string to hand
+// to the Mounter. "defaults" is a no-op, matching mount(8), and the
+// userspace-only keywords in pseudoMountOptions are dropped rather than
+// forwarded as fs-specific data. Anything else not in fstabOptions is passed
+// through unchanged, comma separated, in data.
+func parseMountOptions(opts string) (flags uintptr, data string) {
+	var rest []string
+	for _, opt := range strings.Split(opts, ",") {
+		if opt == "" || opt == "defaults" || isPseudoMountOption(opt) {
+			continue
+		}
+		found := false
+		for _, x := range fstabOptions {
+			if opt == x.name {
+				if x.set {
+					flags |= x.bits
+				} else {
+					flags &^= x.bits
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			rest = append(rest, opt)
+		}
+	}
+	return flags, strings.Join(rest, ",")
+}
+
 func (mount) String() string { return "mount" }
 func (mount) Usage() string  { return "mount [OPTION]... DEVICE [DIRECTORY]" }
 
@@ -126,12 +253,36 @@ func (mount mount) Main(args ...string) error {
 		"-iversion",
 		"-no-iversion",
 		"-strictatime",
-		"-no-strictatime")
-	parm, args := parms.New(args, "-match", "-o", "-t")
+		"-no-strictatime",
+		"-rbind",
+		"-rprivate",
+		"-rslave",
+		"-rshared",
+		"-runbindable",
+		"-lazytime",
+		"-nosymfollow",
+		"-make-shared",
+		"-make-slave",
+		"-make-private",
+		"-make-unbindable",
+		"-make-rshared",
+		"-make-rslave",
+		"-make-rprivate",
+		"-make-runbindable")
+	parm, args := parms.New(args, "-match", "-o", "-t", "-parallel")
 	if len(parm["-t"]) == 0 {
 		parm["-t"] = "auto"
 	}
 
+	for _, x := range makeTranslations {
+		if flag[x.name] {
+			if len(args) != 1 {
+				return fmt.Errorf("%s: expected a single TARGET", x.name)
+			}
+			return doMount("", args[0], "", x.bits, "")
+		}
+	}
+
 	filesystems.all.name = "all"
 	filesystems.auto.name = "auto"
 	var err error
@@ -145,7 +296,7 @@ func (mount mount) Main(args ...string) error {
 			err = mount.fstab(args[0], flag, parm)
 		case 2:
 			err = mount.one(parm["-t"], args[0], args[1], flag,
-				parm)
+				parm, "")
 		default:
 			err = fmt.Errorf("%v: unexpected", args[2:])
 		}
@@ -153,15 +304,138 @@ func (mount mount) Main(args ...string) error {
 	return err
 }
 
+const (
+	mountRetries    = 5
+	mountRetryDelay = 200 * time.Millisecond
+)
+
+// matchesFstab reports whether x satisfies at least one of the
+// comma-separated "-match" predicates: an fsType, a mountpoint prefix, or an
+// fstab option keyword (e.g. "-match nofail,_netdev"). No predicates match
+// everything.
+func matchesFstab(match string, x fstabEntry) bool {
+	var any bool
+	for _, m := range strings.Split(match, ",") {
+		if m == "" {
+			continue
+		}
+		any = true
+		if m == x.fsType || strings.HasPrefix(x.fsFile, m) ||
+			hasMountOpt(x.mntOpts, m) {
+			return true
+		}
+	}
+	return !any
+}
+
+func hasMountOpt(opts, name string) bool {
+	for _, opt := range strings.Split(opts, ",") {
+		if opt == name {
+			return true
+		}
+	}
+	return false
+}
+
+// mountpointDepth counts path separators, so "/" sorts before "/mnt" which
+// sorts before "/mnt/sub": mounting in non-decreasing depth order ensures a
+// parent is always mounted before its children.
+func mountpointDepth(path string) int {
+	return strings.Count(strings.TrimRight(path, "/"), "/")
+}
+
 func (mount mount) all(flag flags.Flag, parm parms.Parm) error {
 	fstab, err := mount.loadFstab()
 	if err != nil {
 		return err
 	}
+
+	var entries []fstabEntry
 	for _, x := range fstab {
-		err = mount.one(x.fsType, x.fsSpec, x.fsFile, flag, parm)
-		if err != nil {
-			break
+		if !matchesFstab(parm["-match"], x) || hasMountOpt(x.mntOpts, "noauto") {
+			continue
+		}
+		entries = append(entries, x)
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return mountpointDepth(entries[i].fsFile) <
+			mountpointDepth(entries[j].fsFile)
+	})
+
+	parallel := 1
+	if n, perr := strconv.Atoi(parm["-parallel"]); perr == nil && n > 0 {
+		parallel = n
+	}
+
+	var (
+		mu      sync.Mutex
+		failed  []string
+		skipped int
+	)
+	for i := 0; i < len(entries); {
+		// Mount one depth level at a time so a child never races its
+		// parent, but let up to "parallel" same-depth siblings run
+		// concurrently.
+		j := i + 1
+		for j < len(entries) && mountpointDepth(entries[j].fsFile) ==
+			mountpointDepth(entries[i].fsFile) {
+			j++
+		}
+		sem := make(chan struct{}, parallel)
+		var wg sync.WaitGroup
+		for _, x := range entries[i:j] {
+			x := x
+			wg.Add(1)
+			sem <- struct{}{}
+			go func() {
+				defer wg.Done()
+				defer func() { <-sem }()
+				err := mount.oneRetry(x.fsType, x.fsSpec, x.fsFile,
+					flag, parm, x.mntOpts)
+				if err == nil {
+					return
+				}
+				mu.Lock()
+				defer mu.Unlock()
+				if hasMountOpt(x.mntOpts, "nofail") {
+					skipped++
+					if flag["-v"] {
+						fmt.Println(x.fsFile,
+							"nofail, ignoring:", err)
+					}
+					return
+				}
+				failed = append(failed, fmt.Sprintf("%s: %v",
+					x.fsFile, err))
+			}()
+		}
+		wg.Wait()
+		i = j
+	}
+
+	if flag["-v"] {
+		fmt.Printf("mount -a: %d mounted, %d failed, %d nofail skipped\n",
+			len(entries)-len(failed)-skipped, len(failed), skipped)
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("%s", strings.Join(failed, "; "))
+	}
+	return nil
+}
+
+// oneRetry is mount.one with bounded, backed-off retries for the transient
+// EBUSY/ENOENT a device node or network share may still be returning just
+// after boot.
+func (mount mount) oneRetry(t, dev, dir string, flag flags.Flag, parm parms.Parm,
+	fstabOpts string) error {
+	var err error
+	for attempt := 0; attempt < mountRetries; attempt++ {
+		if attempt > 0 {
+			time.Sleep(mountRetryDelay * time.Duration(attempt))
+		}
+		err = mount.one(t, dev, dir, flag, parm, fstabOpts)
+		if err == nil || !retryableMountErr(err) {
+			return err
 		}
 	}
 	return err
@@ -175,7 +449,7 @@ func (mount mount) fstab(name string, flag flags.Flag, parm parms.Parm) error {
 	for _, x := range fstab {
 		if name == x.fsSpec || name == x.fsFile {
 			return mount.one(x.fsType, x.fsSpec, x.fsFile,
-				flag, parm)
+				flag, parm, x.mntOpts)
 		}
 	}
 	return nil
@@ -204,19 +478,27 @@ func (mount) loadFstab() ([]fstabEntry, error) {
 	return fstab, scanner.Err()
 }
 
-func (mount) one(t, dev, dir string, flag flags.Flag, parm parms.Parm) error {
+// doMount is the single primitive that actually invokes the platform
+// mounter; both the CLI path (one, and the -make-* dispatch in Main) and
+// the Mount type below go through it.
+func doMount(source, target, fstype string, flags uintptr, data string) error {
+	return mounter.Mount(source, target, fstype, flags, data)
+}
+
+func (mount) one(t, dev, dir string, flag flags.Flag, parm parms.Parm,
+	fstabOpts string) error {
 	var flags uintptr
 	if flag["-defaults"] {
 		//  rw, suid, dev, exec, auto, nouser, async
-		flags &^= syscall.MS_RDONLY
-		flags &^= syscall.MS_NOSUID
-		flags &^= syscall.MS_NODEV
-		flags &^= syscall.MS_NOEXEC
+		flags &^= MS_RDONLY
+		flags &^= MS_NOSUID
+		flags &^= MS_NODEV
+		flags &^= MS_NOEXEC
 		if t == "" {
 			t = "auto"
 		}
 		flags |= MS_NOUSER
-		flags |= syscall.MS_ASYNC
+		flags |= MS_ASYNC
 	}
 	for _, x := range translations {
 		if flag[x.name] {
@@ -227,6 +509,40 @@ func (mount) one(t, dev, dir string, flag flags.Flag, parm parms.Parm) error {
 			}
 		}
 	}
+	optFlags, data := parseMountOptions(parm["-o"])
+	flags |= optFlags
+	if fstabOpts != "" {
+		fstabFlags, fstabData := parseMountOptions(fstabOpts)
+		flags |= fstabFlags
+		switch {
+		case data == "":
+			data = fstabData
+		case fstabData != "":
+			data += "," + fstabData
+		}
+	}
+
+	switch {
+	case flags&MS_REMOUNT != 0:
+		// Remounting something that isn't mounted yet will just
+		// confuse the kernel with an unhelpful EINVAL; say so plainly.
+		if mounted, err := isMounted(dir); err == nil && !mounted {
+			return fmt.Errorf("%s: not mounted", dir)
+		}
+	case flags&MS_BIND != 0:
+		// bind mounts are always attempted; the kernel is the
+		// authority on whether dir is a valid bind target.
+	case flag["-a"]:
+		// Make "-a" idempotent: skip entries already mounted at
+		// their target instead of failing with EBUSY.
+		if mounted, err := isMounted(dir); err == nil && mounted {
+			if flag["-v"] {
+				fmt.Println(dir, "already mounted")
+			}
+			return nil
+		}
+	}
+
 	if flag["--fake"] {
 		fmt.Println("Would mount", dev, "type", t, "at", dir)
 		return nil
@@ -239,7 +555,7 @@ func (mount) one(t, dev, dir string, flag flags.Flag, parm parms.Parm) error {
 
 	var err error
 	for _, t := range tryTypes {
-		err = syscall.Mount(dev, dir, t, flags, parm["-o"])
+		err = doMount(dev, dir, t, flags, data)
 		if err == nil {
 			if flag["-v"] {
 				fmt.Println("Mounted", dev, "at", dir)
@@ -248,53 +564,98 @@ func (mount) one(t, dev, dir string, flag flags.Flag, parm parms.Parm) error {
 		}
 	}
 	if err != nil {
-		return fmt.Errorf("%s: %v", dev, err)
+		return fmt.Errorf("%s: %w", dev, err)
 	}
 	return nil
 }
 
+// listMounts is set by the per-OS mount_$GOOS.go to whatever can stand in
+// for /proc/self/mountinfo on that platform.
 func (mount) show() error {
-	f, err := os.Open("/proc/mounts")
-	if err != nil {
-		return err
-	}
-	defer f.Close()
+	return listMounts()
+}
 
-	scanner := bufio.NewScanner(f)
-	for scanner.Scan() {
-		fields := strings.Fields(scanner.Text())
-		fmt.Print(fields[0], " on ", fields[1], " type ", fields[2],
-			"(", fields[3], ")\n")
+// Mount describes a single mount point programmatically, for callers that
+// want the mount(8) semantics without building and parsing an argv. Options
+// are the same comma-separated keywords accepted by -o and fstab's fourth
+// field (see fstabOptions).
+type Mount struct {
+	Source  string
+	Target  string
+	Type    string
+	Options []string
+}
 
-	}
-	return scanner.Err()
+// Mount performs the mount described by m.
+func (m Mount) Mount() error {
+	flags, data := parseMountOptions(strings.Join(m.Options, ","))
+	return doMount(m.Source, m.Target, m.Type, flags, data)
 }
 
-func (fs *Filesystems) List() []string {
-	if len(fs.list) > 0 {
-		return fs.list
+// Unmount tears down m.Target; flags are the MNT_* bits accepted by
+// umount(2) (0 for a plain unmount).
+func (m Mount) Unmount(flags int) error {
+	return mounter.Unmount(m.Target, flags)
+}
+
+// MountAll performs each of mounts in order, stopping at the first failure.
+func MountAll(mounts []Mount) error {
+	for _, m := range mounts {
+		if err := m.Mount(); err != nil {
+			return fmt.Errorf("%s: %w", m.Target, err)
+		}
 	}
-	f, err := os.Open(procFilesystems)
+	return nil
+}
+
+// mountsUnder is set by the per-OS mount_$GOOS.go to list the mount points
+// at or under prefix, in no particular order.
+//
+// UnmountAll unmounts every mount point at or under target, deepest first,
+// so that a nested bind mount doesn't block the unmount of its parent.
+func UnmountAll(target string) error {
+	points, err := mountsUnder(target)
 	if err != nil {
-		return fs.list
+		return err
 	}
-	defer f.Close()
+	sort.Slice(points, func(i, j int) bool {
+		return mountpointDepth(points[i]) > mountpointDepth(points[j])
+	})
+	for _, p := range points {
+		if err := mounter.Unmount(p, 0); err != nil {
+			return fmt.Errorf("%s: %w", p, err)
+		}
+	}
+	return nil
+}
 
-	scanner := bufio.NewScanner(f)
-	for scanner.Scan() {
-		line := scanner.Text()
-		if strings.HasPrefix(line, "nodev") {
-			if fs.name == "auto" {
-				continue
+// List returns the filesystem types this Filesystems tracks, reading
+// procFilesystems once and caching the result. The once guards the cache
+// against the concurrent callers mount.all's "-parallel" siblings produce.
+func (fs *Filesystems) List() []string {
+	fs.once.Do(func() {
+		f, err := os.Open(procFilesystems)
+		if err != nil {
+			return
+		}
+		defer f.Close()
+
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			line := scanner.Text()
+			if strings.HasPrefix(line, "nodev") {
+				if fs.name == "auto" {
+					continue
+				}
+				line = strings.TrimPrefix(line, "nodev")
 			}
-			line = strings.TrimPrefix(line, "nodev")
+			line = strings.TrimSpace(line)
+			fs.list = append(fs.list, line)
 		}
-		line = strings.TrimSpace(line)
-		fs.list = append(fs.list, line)
-	}
-	if err := scanner.Err(); err != nil {
-		fmt.Fprintln(os.Stderr, "scan:", procFilesystems, err)
-	}
+		if err := scanner.Err(); err != nil {
+			fmt.Fprintln(os.Stderr, "scan:", procFilesystems, err)
+		}
+	})
 	return fs.list
 }
 
@@ -318,12 +679,22 @@ DESCRIPTION
 OPTIONS
 	--fake
 	-v		verbose
-	-a		all [-match MATCH[,...]]
+	-a		all [-match MATCH[,...]] [-parallel N]
 	-t FSTYPE[,...]
 	-o FSOPT[,...]
 
 	Where MATCH, FSTYPE and FSOPT are comma separated lists.
 
+	"-a" mounts every /etc/fstab entry not marked "noauto", skipping
+	ones already mounted, parents before children, retrying a few
+	times on EBUSY/ENOENT. MATCH restricts this to entries whose
+	fsType, mountpoint prefix, or options match one of its comma
+	separated elements (e.g. "-match nofail,_netdev"). PARALLEL
+	(default 1) bounds how many entries at the same mountpoint depth
+	may be mounted concurrently. An entry with "nofail" in its options
+	that fails to mount doesn't fail the whole "-a"; its error is
+	printed only with "-v".
+
 FSTYPE
 	May be anything listed in /proc/filesystems; for example:
 	sysfs, ramfs, proc, tmpfs, devtmpfs, debugfs, securityfs,
@@ -367,6 +738,23 @@ FILESYSTEM INDEPENDENT FLAGS
 	-iversion	Update inode I-Version field
 	-no-iversion	Don't update inode I-Version field
 	-strictatime	Always perform atime updates
-	-no-strictatime	May skip atime updates`,
+	-no-strictatime	May skip atime updates
+	-rbind		Bind a directory recursively
+	-rprivate	Change to private subtree, recursively
+	-rslave		Change to slave subtree, recursively
+	-rshared	Change to shared subtree, recursively
+	-runbindable	Change to unbindable subtree, recursively
+	-lazytime	Defer inode timestamp updates to disk
+	-nosymfollow	Don't follow symlinks when resolving this mount
+
+PROPAGATION ONLY (TARGET, no DEVICE)
+	-make-shared	Make an existing mount point shared
+	-make-slave	Make an existing mount point a slave
+	-make-private	Make an existing mount point private
+	-make-unbindable	Make an existing mount point unbindable
+	-make-rshared	Same, recursively
+	-make-rslave	Same, recursively
+	-make-rprivate	Same, recursively
+	-make-runbindable	Same, recursively`,
 	}
 }
@@ -0,0 +1,131 @@
+// Copyright 2015-2016 Platina Systems, Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style license described in the
+// LICENSE file.
+
+package mount
+
+import (
+	"bytes"
+	"fmt"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	MS_RDONLY      = unix.MNT_RDONLY
+	MS_NOSUID      = unix.MNT_NOSUID
+	MS_NODEV       = 0
+	MS_NOEXEC      = unix.MNT_NOEXEC
+	MS_SYNCHRONOUS = unix.MNT_SYNCHRONOUS
+	MS_REMOUNT     = unix.MNT_UPDATE
+	MS_MANDLOCK    = 0
+	MS_DIRSYNC     = 0
+	MS_NOATIME     = 0
+	MS_NODIRATIME  = 0
+	MS_BIND        = 0
+	MS_MOVE        = 0
+	MS_SILENT      = 0
+	MS_POSIXACL    = 0
+	MS_UNBINDABLE  = 0
+	MS_PRIVATE     = 0
+	MS_SLAVE       = 0
+	MS_SHARED      = 0
+	MS_RELATIME    = 0
+	MS_I_VERSION   = 0
+	MS_STRICTATIME = 0
+	MS_REC         = 0
+	MS_ASYNC       = 0
+	MS_NOUSER      = 0
+	MS_LAZYTIME    = 0
+	MS_NOSYMFOLLOW = 0
+)
+
+type darwinMounter struct{}
+
+// Mount is best-effort on Darwin: unlike FreeBSD, x/sys/unix exposes no
+// Nmount here, and mount(2)'s fourth argument is a filesystem-specific args
+// struct (e.g. hfs_mount_args) rather than a generic name/value list, so
+// there is no generic way to pass "data" through correctly for an arbitrary
+// fstype. This only has a chance of working for filesystems that, like
+// msdos and cd9660, accept a bare "from=" C-string in place of their real
+// args struct.
+func (darwinMounter) Mount(source, target, fstype string, flags uintptr, data string) error {
+	var nativeFlags int
+	for _, bit := range []struct{ ms, mnt uintptr }{
+		{MS_RDONLY, unix.MNT_RDONLY},
+		{MS_NOSUID, unix.MNT_NOSUID},
+		{MS_NOEXEC, unix.MNT_NOEXEC},
+		{MS_SYNCHRONOUS, unix.MNT_SYNCHRONOUS},
+		{MS_REMOUNT, unix.MNT_UPDATE},
+	} {
+		if flags&bit.ms != 0 {
+			nativeFlags |= int(bit.mnt)
+		}
+	}
+
+	opts := "from=" + source
+	if data != "" {
+		opts += "," + data
+	}
+	optsPtr, err := unix.BytePtrFromString(opts)
+	if err != nil {
+		return err
+	}
+	return unix.Mount(fstype, target, nativeFlags, unsafe.Pointer(optsPtr))
+}
+
+func (darwinMounter) Unmount(target string, flags int) error {
+	return unix.Unmount(target, flags)
+}
+
+var mounter Mounter = darwinMounter{}
+
+// isMounted reports whether path is itself a mount point, by scanning
+// getfsstat(2) for a matching Mntonname.
+var isMounted = func(path string) (bool, error) {
+	n, err := unix.Getfsstat(nil, unix.MNT_NOWAIT)
+	if err != nil {
+		return false, err
+	}
+	buf := make([]unix.Statfs_t, n)
+	if _, err := unix.Getfsstat(buf, unix.MNT_NOWAIT); err != nil {
+		return false, err
+	}
+	for _, st := range buf {
+		if cString(st.Mntonname[:]) == path {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+var retryableMountErr = func(err error) bool { return false }
+
+var listMounts = func() error {
+	n, err := unix.Getfsstat(nil, unix.MNT_NOWAIT)
+	if err != nil {
+		return err
+	}
+	buf := make([]unix.Statfs_t, n)
+	if _, err := unix.Getfsstat(buf, unix.MNT_NOWAIT); err != nil {
+		return err
+	}
+	for _, st := range buf {
+		fmt.Print(cString(st.Mntfromname[:]), " on ",
+			cString(st.Mntonname[:]), " type ",
+			cString(st.Fstypename[:]), "\n")
+	}
+	return nil
+}
+
+func cString(b []byte) string {
+	if i := bytes.IndexByte(b, 0); i >= 0 {
+		b = b[:i]
+	}
+	return string(b)
+}
+
+var mountsUnder = func(prefix string) ([]string, error) {
+	return nil, fmt.Errorf("mount: listing mounts is not supported on darwin")
+}
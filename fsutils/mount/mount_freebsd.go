@@ -0,0 +1,158 @@
+// Copyright 2015-2016 Platina Systems, Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style license described in the
+// LICENSE file.
+
+package mount
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	MS_RDONLY      = unix.MNT_RDONLY
+	MS_NOSUID      = unix.MNT_NOSUID
+	MS_NODEV       = 0
+	MS_NOEXEC      = unix.MNT_NOEXEC
+	MS_SYNCHRONOUS = unix.MNT_SYNCHRONOUS
+	MS_REMOUNT     = unix.MNT_UPDATE
+	MS_MANDLOCK    = 0
+	MS_DIRSYNC     = 0
+	MS_NOATIME     = unix.MNT_NOATIME
+	MS_NODIRATIME  = 0
+	MS_BIND        = 0
+	MS_MOVE        = 0
+	MS_SILENT      = 0
+	MS_POSIXACL    = 0
+	MS_UNBINDABLE  = 0
+	MS_PRIVATE     = 0
+	MS_SLAVE       = 0
+	MS_SHARED      = 0
+	MS_RELATIME    = 0
+	MS_I_VERSION   = 0
+	MS_STRICTATIME = 0
+	MS_REC         = 0
+	MS_ASYNC       = 0
+	MS_NOUSER      = 0
+	MS_LAZYTIME    = 0
+	MS_NOSYMFOLLOW = 0
+)
+
+type bsdMounter struct{}
+
+// buildIovec turns a flat "name1", "value1", "name2", "value2", ... list
+// into the []unix.Iovec nmount(2) expects: each name/value is a
+// NUL-terminated byte string, and a boolean-only option (e.g. "ro") is
+// passed with a zero-length value.
+func buildIovec(items []string) ([]unix.Iovec, error) {
+	iov := make([]unix.Iovec, len(items))
+	for i, item := range items {
+		b, err := unix.ByteSliceFromString(item)
+		if err != nil {
+			return nil, err
+		}
+		iov[i].Base = &b[0]
+		iov[i].SetLen(len(b))
+	}
+	return iov, nil
+}
+
+func (bsdMounter) Mount(source, target, fstype string, flags uintptr, data string) error {
+	var nativeFlags int
+	for _, bit := range []struct{ ms, mnt uintptr }{
+		{MS_RDONLY, unix.MNT_RDONLY},
+		{MS_NOSUID, unix.MNT_NOSUID},
+		{MS_NOEXEC, unix.MNT_NOEXEC},
+		{MS_SYNCHRONOUS, unix.MNT_SYNCHRONOUS},
+		{MS_NOATIME, unix.MNT_NOATIME},
+		{MS_REMOUNT, unix.MNT_UPDATE},
+	} {
+		if flags&bit.ms != 0 {
+			nativeFlags |= int(bit.mnt)
+		}
+	}
+
+	// nmount(2) takes its options as name/value pairs rather than
+	// mount(2)'s single opaque data blob; "fstype"/"fspath"/"from" are
+	// the ones every filesystem understands. Each comma-separated option
+	// in data is forwarded as a name/value pair split at its first "=",
+	// or as a boolean option with an empty value when it has no "=".
+	items := []string{
+		"fstype", fstype,
+		"fspath", target,
+		"from", source,
+	}
+	for _, opt := range strings.Split(data, ",") {
+		if opt == "" {
+			continue
+		}
+		if i := strings.IndexByte(opt, '='); i >= 0 {
+			items = append(items, opt[:i], opt[i+1:])
+		} else {
+			items = append(items, opt, "")
+		}
+	}
+	iov, err := buildIovec(items)
+	if err != nil {
+		return err
+	}
+	return unix.Nmount(iov, nativeFlags)
+}
+
+func (bsdMounter) Unmount(target string, flags int) error {
+	return unix.Unmount(target, flags)
+}
+
+var mounter Mounter = bsdMounter{}
+
+// isMounted reports whether path is itself a mount point, by scanning
+// getfsstat(2) for a matching Mntonname.
+var isMounted = func(path string) (bool, error) {
+	n, err := unix.Getfsstat(nil, unix.MNT_NOWAIT)
+	if err != nil {
+		return false, err
+	}
+	buf := make([]unix.Statfs_t, n)
+	if _, err := unix.Getfsstat(buf, unix.MNT_NOWAIT); err != nil {
+		return false, err
+	}
+	for _, st := range buf {
+		if cString(st.Mntonname[:]) == path {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+var retryableMountErr = func(err error) bool { return false }
+
+var listMounts = func() error {
+	n, err := unix.Getfsstat(nil, unix.MNT_NOWAIT)
+	if err != nil {
+		return err
+	}
+	buf := make([]unix.Statfs_t, n)
+	if _, err := unix.Getfsstat(buf, unix.MNT_NOWAIT); err != nil {
+		return err
+	}
+	for _, st := range buf {
+		fmt.Print(cString(st.Mntfromname[:]), " on ",
+			cString(st.Mntonname[:]), " type ",
+			cString(st.Fstypename[:]), "\n")
+	}
+	return nil
+}
+
+func cString(b []byte) string {
+	if i := bytes.IndexByte(b, 0); i >= 0 {
+		b = b[:i]
+	}
+	return string(b)
+}
+
+var mountsUnder = func(prefix string) ([]string, error) {
+	return nil, fmt.Errorf("mount: listing mounts is not supported on freebsd")
+}
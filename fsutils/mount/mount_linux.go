@@ -0,0 +1,101 @@
+// Copyright 2015-2016 Platina Systems, Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style license described in the
+// LICENSE file.
+
+package mount
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/platinasystems/go/fsutils/mountinfo"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	MS_RDONLY      = unix.MS_RDONLY
+	MS_NOSUID      = unix.MS_NOSUID
+	MS_NODEV       = unix.MS_NODEV
+	MS_NOEXEC      = unix.MS_NOEXEC
+	MS_SYNCHRONOUS = unix.MS_SYNCHRONOUS
+	MS_REMOUNT     = unix.MS_REMOUNT
+	MS_MANDLOCK    = unix.MS_MANDLOCK
+	MS_DIRSYNC     = unix.MS_DIRSYNC
+	MS_NOATIME     = unix.MS_NOATIME
+	MS_NODIRATIME  = unix.MS_NODIRATIME
+	MS_BIND        = unix.MS_BIND
+	MS_MOVE        = unix.MS_MOVE
+	MS_SILENT      = unix.MS_SILENT
+	MS_POSIXACL    = unix.MS_POSIXACL
+	MS_UNBINDABLE  = unix.MS_UNBINDABLE
+	MS_PRIVATE     = unix.MS_PRIVATE
+	MS_SLAVE       = unix.MS_SLAVE
+	MS_SHARED      = unix.MS_SHARED
+	MS_RELATIME    = unix.MS_RELATIME
+	MS_I_VERSION   = unix.MS_I_VERSION
+	MS_STRICTATIME = unix.MS_STRICTATIME
+	MS_REC         = unix.MS_REC
+	MS_ASYNC       = unix.MS_ASYNC
+
+	// hack around syscall/unix incomplete definitions
+	MS_NOUSER uintptr = 1 << 31
+
+	// MS_LAZYTIME (kernel >= 4.0) and MS_NOSYMFOLLOW (kernel >= 5.10)
+	// haven't made it into every golang.org/x/sys/unix release, so spell
+	// the uapi values out directly rather than gate this package on a
+	// bleeding-edge dependency bump.
+	MS_LAZYTIME    uintptr = 1 << 25
+	MS_NOSYMFOLLOW uintptr = 256
+)
+
+type linuxMounter struct{}
+
+func (linuxMounter) Mount(source, target, fstype string, flags uintptr, data string) error {
+	return unix.Mount(source, target, fstype, flags, data)
+}
+
+func (linuxMounter) Unmount(target string, flags int) error {
+	return unix.Unmount(target, flags)
+}
+
+var mounter Mounter = linuxMounter{}
+
+var isMounted = mountinfo.Mounted
+
+// retryableMountErr reports the transient failures a device node or network
+// share may still return just after boot, which are worth a few retries.
+var retryableMountErr = func(err error) bool {
+	return errors.Is(err, unix.EBUSY) || errors.Is(err, unix.ENOENT)
+}
+
+var listMounts = func() error {
+	mounts, err := mountinfo.GetMounts()
+	if err != nil {
+		return err
+	}
+	for _, m := range mounts {
+		opts := m.Opts
+		if m.VfsOpts != "" {
+			opts += "," + m.VfsOpts
+		}
+		fmt.Print(m.Source, " on ", m.Mountpoint, " type ", m.Fstype,
+			"(", opts, ")\n")
+	}
+	return nil
+}
+
+var mountsUnder = func(prefix string) ([]string, error) {
+	mounts, err := mountinfo.GetMounts()
+	if err != nil {
+		return nil, err
+	}
+	var points []string
+	for _, m := range mounts {
+		if m.Mountpoint == prefix ||
+			strings.HasPrefix(m.Mountpoint, prefix+"/") {
+			points = append(points, m.Mountpoint)
+		}
+	}
+	return points, nil
+}
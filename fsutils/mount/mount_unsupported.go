@@ -0,0 +1,62 @@
+// Copyright 2015-2016 Platina Systems, Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style license described in the
+// LICENSE file.
+
+// +build !linux,!freebsd,!darwin,!windows
+
+package mount
+
+import "fmt"
+
+const (
+	MS_RDONLY      = 0
+	MS_NOSUID      = 0
+	MS_NODEV       = 0
+	MS_NOEXEC      = 0
+	MS_SYNCHRONOUS = 0
+	MS_REMOUNT     = 0
+	MS_MANDLOCK    = 0
+	MS_DIRSYNC     = 0
+	MS_NOATIME     = 0
+	MS_NODIRATIME  = 0
+	MS_BIND        = 0
+	MS_MOVE        = 0
+	MS_SILENT      = 0
+	MS_POSIXACL    = 0
+	MS_UNBINDABLE  = 0
+	MS_PRIVATE     = 0
+	MS_SLAVE       = 0
+	MS_SHARED      = 0
+	MS_RELATIME    = 0
+	MS_I_VERSION   = 0
+	MS_STRICTATIME = 0
+	MS_REC         = 0
+	MS_ASYNC       = 0
+	MS_NOUSER      = 0
+	MS_LAZYTIME    = 0
+	MS_NOSYMFOLLOW = 0
+)
+
+type unsupportedMounter struct{}
+
+func (unsupportedMounter) Mount(source, target, fstype string, flags uintptr, data string) error {
+	return fmt.Errorf("mount: not supported on this platform")
+}
+
+func (unsupportedMounter) Unmount(target string, flags int) error {
+	return fmt.Errorf("mount: not supported on this platform")
+}
+
+var mounter Mounter = unsupportedMounter{}
+
+var isMounted = func(path string) (bool, error) { return false, nil }
+
+var retryableMountErr = func(err error) bool { return false }
+
+var listMounts = func() error {
+	return fmt.Errorf("mount: not supported on this platform")
+}
+
+var mountsUnder = func(prefix string) ([]string, error) {
+	return nil, fmt.Errorf("mount: not supported on this platform")
+}
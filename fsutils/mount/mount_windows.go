@@ -0,0 +1,77 @@
+// Copyright 2015-2016 Platina Systems, Inc. All rights reserved.
+// Use of this source code is governed by a BSD-style license described in the
+// LICENSE file.
+
+package mount
+
+import (
+	"fmt"
+	"os"
+)
+
+const (
+	MS_RDONLY      = 0
+	MS_NOSUID      = 0
+	MS_NODEV       = 0
+	MS_NOEXEC      = 0
+	MS_SYNCHRONOUS = 0
+	MS_REMOUNT     = 0
+	MS_MANDLOCK    = 0
+	MS_DIRSYNC     = 0
+	MS_NOATIME     = 0
+	MS_NODIRATIME  = 0
+	MS_BIND        = 1 << 0
+	MS_MOVE        = 0
+	MS_SILENT      = 0
+	MS_POSIXACL    = 0
+	MS_UNBINDABLE  = 0
+	MS_PRIVATE     = 0
+	MS_SLAVE       = 0
+	MS_SHARED      = 0
+	MS_RELATIME    = 0
+	MS_I_VERSION   = 0
+	MS_STRICTATIME = 0
+	MS_REC         = 0
+	MS_ASYNC       = 0
+	MS_NOUSER      = 0
+	MS_LAZYTIME    = 0
+	MS_NOSYMFOLLOW = 0
+)
+
+// windowsMounter emulates "mount" with a directory symlink, since Windows
+// has no mount(2) equivalent for an arbitrary source/target pair.
+type windowsMounter struct{}
+
+func (windowsMounter) Mount(source, target, fstype string, flags uintptr, data string) error {
+	if flags&MS_BIND == 0 {
+		return fmt.Errorf("mount: only -bind is supported on windows")
+	}
+	if err := os.RemoveAll(target); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return os.Symlink(source, target)
+}
+
+func (windowsMounter) Unmount(target string, flags int) error {
+	return os.Remove(target)
+}
+
+var mounter Mounter = windowsMounter{}
+
+var isMounted = func(path string) (bool, error) {
+	fi, err := os.Lstat(path)
+	if err != nil {
+		return false, err
+	}
+	return fi.Mode()&os.ModeSymlink != 0, nil
+}
+
+var retryableMountErr = func(err error) bool { return false }
+
+var listMounts = func() error {
+	return fmt.Errorf("mount: listing mounts is not supported on windows")
+}
+
+var mountsUnder = func(prefix string) ([]string, error) {
+	return nil, fmt.Errorf("mount: listing mounts is not supported on windows")
+}